@@ -0,0 +1,69 @@
+// Command quizserver starts the quiz1 quiz as a real gRPC service with
+// a grpc-gateway REST façade in front of it, so the same quiz can be
+// driven from the CLI client in quizserver/client, a browser hitting
+// the REST endpoints, or any other gRPC client.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"gophercises/quizserver"
+	"gophercises/quizserver/quizpb"
+)
+
+func main() {
+	grpcAddr := flag.String("grpc-addr", ":9000", "address for the gRPC listener")
+	httpAddr := flag.String("http-addr", ":8081", "address for the grpc-gateway REST façade")
+	quizDir := flag.String("dir", "quiz1", "directory containing <quiz>.csv files")
+	problemTimeout := flag.Duration("problem-timeout", 10*time.Second, "default per-problem timeout when a QuizConfig doesn't set one")
+	flag.Parse()
+
+	lis, err := net.Listen("tcp", *grpcAddr)
+	if err != nil {
+		log.Fatalf("quizserver: failed to listen on %s: %v", *grpcAddr, err)
+	}
+
+	grpcServer := grpc.NewServer()
+	srv := quizserver.NewServer(quizserver.NewQuizRepository(*quizDir), *problemTimeout)
+	quizpb.RegisterQuizServiceServer(grpcServer, srv)
+
+	go func() {
+		log.Printf("quizserver: gRPC listening on %s\n", *grpcAddr)
+		if err := grpcServer.Serve(lis); err != nil {
+			log.Fatalf("quizserver: gRPC server stopped: %v", err)
+		}
+	}()
+
+	if err := serveGateway(*grpcAddr, *httpAddr); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// serveGateway dials the gRPC listener at grpcAddr and serves the
+// grpc-gateway REST façade on httpAddr until it fails.
+func serveGateway(grpcAddr, httpAddr string) error {
+	conn, err := grpc.NewClient(grpcAddr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.CallContentSubtype(quizpb.CodecName)),
+	)
+	if err != nil {
+		return err
+	}
+
+	gwMux := runtime.NewServeMux()
+	if err := quizpb.RegisterQuizServiceHandler(context.Background(), gwMux, conn); err != nil {
+		return err
+	}
+
+	log.Printf("quizserver: REST façade listening on %s\n", httpAddr)
+	return http.ListenAndServe(httpAddr, gwMux)
+}
@@ -1,13 +1,15 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"log"
 	"os"
 	"strings"
 	"time"
+
+	"gophercises/jsonstream"
 )
 
 type fruitBasket struct {
@@ -130,35 +132,17 @@ func main() {
 	/*
 	Reading JSON files
 	The json.Decoder and json.Encoder types in package encoding/json offer support for reading and writing streams, e.g.
-	files, of JSON data.
+	files, of JSON data. jsonstream builds on that to stream NDJSON of any size through a declarative pipeline instead
+	of a hand-rolled decode/transform/encode loop.
 	The code in this example:
 		- reads a stream of JSON objects from a Reader (strings.Reader),
 		- removes the Age field from each object,
 		- and then writes the objects to a Writer (os.Stdout).
 	*/
 	reader := strings.NewReader(string(tmpJsonData3))
-	writer := os.Stdout
-	decoder := json.NewDecoder(reader)
-	encoder := json.NewEncoder(writer)
-	for {
-		// Read one JSON object and store it in a map(decode)
-		var m map[string]interface{}
-		if err := decoder.Decode(&m); err == io.EOF {
-			break
-		} else if err != nil {
-			log.Fatalln(err)
-		}
-
-		// Remove all key-value pairs with key == "Age" from the map
-		for k := range m {
-			if k == "Age" {
-				delete(m, k)
-			}
-		}
-
-		// Write the map as a JSON object(encode)
-		if err := encoder.Encode(&m); err != nil {
-			log.Println(err)
-		}
+	stats, err := jsonstream.New(reader, os.Stdout).Drop("Age").Run(context.Background())
+	if err != nil {
+		log.Println(err)
 	}
+	log.Printf("jsonstream: read=%d written=%d dropped=%d errors=%d\n", stats.Read, stats.Written, stats.Dropped, stats.Errors)
 }
\ No newline at end of file
@@ -0,0 +1,69 @@
+package jsonstream
+
+import "strconv"
+
+// pathSegment is one step of a resolved JSONPath expression: either a
+// map key, the wildcard "all elements of this array", or a specific
+// array index.
+type pathSegment struct {
+	key      string
+	wildcard bool
+	index    int
+	hasIndex bool
+}
+
+// parsePath compiles a minimal JSONPath expression into a sequence of
+// pathSegments. It supports the leading "$", dotted keys ("$.Name"),
+// the "[*]" wildcard, and integer indices ("Fruit[0]"), which is enough
+// to select/drop/rename the top-level and one-level-nested fields this
+// package's transforms operate on.
+func parsePath(path string) []pathSegment {
+	var segments []pathSegment
+	i := 0
+	n := len(path)
+	if i < n && path[i] == '$' {
+		i++
+	}
+	if path[i:] == "*" {
+		// A bare "*" (as opposed to "[*]", the array wildcard) means
+		// "every top-level field" and compiles to no segments, the
+		// same as "$" alone; Select treats an empty segment list as
+		// a match for any key.
+		return nil
+	}
+	for i < n {
+		switch {
+		case path[i] == '.':
+			i++
+		case path[i] == '[':
+			j := i + 1
+			for j < n && path[j] != ']' {
+				j++
+			}
+			inner := path[i+1 : j]
+			if inner == "*" {
+				segments = append(segments, pathSegment{wildcard: true})
+			} else if idx, err := strconv.Atoi(inner); err == nil {
+				segments = append(segments, pathSegment{index: idx, hasIndex: true})
+			}
+			i = j + 1
+		default:
+			j := i
+			for j < n && path[j] != '.' && path[j] != '[' {
+				j++
+			}
+			segments = append(segments, pathSegment{key: path[i:j]})
+			i = j
+		}
+	}
+	return segments
+}
+
+// matchesKey reports whether the first segment of a compiled path
+// refers to the top-level field name.
+func matchesKey(segments []pathSegment, key string) bool {
+	if len(segments) == 0 {
+		return false
+	}
+	return segments[0].key == key
+}
@@ -0,0 +1,29 @@
+package jsonstream
+
+import "testing"
+
+func TestParsePathDollarAndStarKeepEverything(t *testing.T) {
+	for _, path := range []string{"$", "*"} {
+		segs := parsePath(path)
+		if len(segs) != 0 {
+			t.Fatalf("parsePath(%q) = %v, want no segments", path, segs)
+		}
+	}
+}
+
+func TestParsePathDottedKey(t *testing.T) {
+	segs := parsePath("$.Name")
+	if len(segs) != 1 || segs[0].key != "Name" {
+		t.Fatalf("parsePath(%q) = %v, want a single %q segment", "$.Name", segs, "Name")
+	}
+}
+
+func TestMatchesKey(t *testing.T) {
+	segs := parsePath("Name")
+	if !matchesKey(segs, "Name") {
+		t.Fatalf("expected %q to match segment %q", "Name", "Name")
+	}
+	if matchesKey(segs, "Age") {
+		t.Fatalf("did not expect %q to match segment %q", "Age", "Name")
+	}
+}
@@ -0,0 +1,255 @@
+// Package jsonstream turns the NDJSON read/transform/write loop from
+// the JSON demo (fruitBasket, tmpJsonData3) into a reusable streaming
+// pipeline: records are decoded one at a time via json.Decoder so
+// multi-GB inputs never have to fit in memory, transformed through a
+// small declarative API, and re-encoded to the output writer in their
+// original order even when transforms run concurrently.
+package jsonstream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// ErrSkip can be returned from a Map function to drop the current
+// record without writing it to the output and without counting it as
+// an error in Stats.
+var ErrSkip = errors.New("jsonstream: skip record")
+
+// record is one decoded NDJSON value together with its input position,
+// used to restore ordering after concurrent processing.
+type record struct {
+	seq int
+	m   map[string]interface{}
+	err error
+}
+
+// transform is one step of the pipeline, applied in declaration order
+// to each record's decoded map.
+type transform func(map[string]interface{}) error
+
+// Pipeline streams NDJSON from r, applies a declared sequence of
+// transforms to each top-level object, and writes the result to w.
+type Pipeline struct {
+	r io.Reader
+	w io.Writer
+
+	transforms []transform
+	workers    int
+
+	stats Stats
+}
+
+// Stats reports what a Pipeline did once Run returns.
+type Stats struct {
+	Read    int
+	Written int
+	Dropped int
+	Errors  int
+}
+
+// New returns a Pipeline reading NDJSON from r and writing the
+// transformed records to w. By default records are processed
+// sequentially; call Workers to fan out.
+func New(r io.Reader, w io.Writer) *Pipeline {
+	return &Pipeline{r: r, w: w, workers: 1}
+}
+
+// Workers sets how many records are transformed concurrently. Output
+// order always matches input order regardless of n.
+func (p *Pipeline) Workers(n int) *Pipeline {
+	if n > 0 {
+		p.workers = n
+	}
+	return p
+}
+
+// Select keeps only the top-level fields matching one of the given
+// JSONPath expressions, dropping everything else. "$.Name" and "Name"
+// are equivalent; "$" or "*" keeps everything.
+func (p *Pipeline) Select(paths ...string) *Pipeline {
+	var compiled [][]pathSegment
+	for _, path := range paths {
+		compiled = append(compiled, parsePath(path))
+	}
+	p.transforms = append(p.transforms, func(m map[string]interface{}) error {
+		for k := range m {
+			keep := false
+			for _, segs := range compiled {
+				if len(segs) == 0 || matchesKey(segs, k) {
+					keep = true
+					break
+				}
+			}
+			if !keep {
+				delete(m, k)
+			}
+		}
+		return nil
+	})
+	return p
+}
+
+// Drop removes the named top-level fields from each record.
+func (p *Pipeline) Drop(keys ...string) *Pipeline {
+	p.transforms = append(p.transforms, func(m map[string]interface{}) error {
+		for _, k := range keys {
+			delete(m, k)
+		}
+		return nil
+	})
+	return p
+}
+
+// Rename renames the top-level field "from" to "to", if present.
+func (p *Pipeline) Rename(from, to string) *Pipeline {
+	p.transforms = append(p.transforms, func(m map[string]interface{}) error {
+		if v, ok := m[from]; ok {
+			delete(m, from)
+			m[to] = v
+		}
+		return nil
+	})
+	return p
+}
+
+// Map applies an arbitrary transform to each record. Returning an error
+// leaves the record out of the output and is tallied in Stats.Errors,
+// not Stats.Dropped; Run reports the first such error. Returning ErrSkip
+// instead counts the record in Stats.Dropped.
+func (p *Pipeline) Map(fn func(map[string]interface{}) error) *Pipeline {
+	p.transforms = append(p.transforms, fn)
+	return p
+}
+
+// Run decodes, transforms, and re-encodes every record from r to w,
+// stopping early if ctx is canceled. It returns Stats describing what
+// happened and the first error encountered, if any.
+func (p *Pipeline) Run(ctx context.Context) (Stats, error) {
+	decoder := json.NewDecoder(p.r)
+	encoder := json.NewEncoder(p.w)
+
+	in := make(chan record)
+	out := make(chan record, p.workers)
+
+	var wg sync.WaitGroup
+	for i := 0; i < p.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for rec := range in {
+				rec = p.apply(rec)
+				select {
+				case out <- rec:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	go func() {
+		defer close(in)
+		seq := 0
+		for {
+			var m map[string]interface{}
+			err := decoder.Decode(&m)
+			if err == io.EOF {
+				return
+			}
+			rec := record{seq: seq, m: m, err: err}
+			seq++
+			select {
+			case in <- rec:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	return p.reorderAndWrite(ctx, out, encoder)
+}
+
+// apply runs every declared transform over rec.m in order, short
+// circuiting and marking rec as errored if one fails.
+func (p *Pipeline) apply(rec record) record {
+	if rec.err != nil {
+		return rec
+	}
+	for _, t := range p.transforms {
+		if err := t(rec.m); err != nil {
+			rec.err = err
+			return rec
+		}
+	}
+	return rec
+}
+
+// reorderAndWrite consumes out-of-order records from out and writes
+// them to enc in input order, buffering whatever arrives early via a
+// small map keyed on sequence number.
+func (p *Pipeline) reorderAndWrite(ctx context.Context, out <-chan record, enc *json.Encoder) (Stats, error) {
+	pending := make(map[int]record)
+	next := 0
+	var firstErr error
+
+	flushReady := func() {
+		for {
+			rec, ok := pending[next]
+			if !ok {
+				return
+			}
+			delete(pending, next)
+			next++
+			p.stats.Read++
+
+			if errors.Is(rec.err, ErrSkip) {
+				p.stats.Dropped++
+				continue
+			}
+			if rec.err != nil {
+				if rec.err == io.EOF {
+					continue
+				}
+				p.stats.Errors++
+				if firstErr == nil {
+					firstErr = fmt.Errorf("jsonstream: %w", rec.err)
+				}
+				continue
+			}
+			if err := enc.Encode(rec.m); err != nil {
+				p.stats.Errors++
+				if firstErr == nil {
+					firstErr = fmt.Errorf("jsonstream: failed to write record: %w", err)
+				}
+				continue
+			}
+			p.stats.Written++
+		}
+	}
+
+	for {
+		select {
+		case rec, ok := <-out:
+			if !ok {
+				flushReady()
+				return p.stats, firstErr
+			}
+			pending[rec.seq] = rec
+			flushReady()
+		case <-ctx.Done():
+			return p.stats, ctx.Err()
+		}
+	}
+}
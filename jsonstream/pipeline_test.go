@@ -0,0 +1,41 @@
+package jsonstream
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestSelectStarKeepsEveryField(t *testing.T) {
+	in := strings.NewReader(`{"Name":"a","Age":3}` + "\n")
+	var out strings.Builder
+
+	stats, err := New(in, &out).Select("*").Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run returned error: %v", err)
+	}
+	if stats.Written != 1 {
+		t.Fatalf("expected 1 record written, got %d", stats.Written)
+	}
+
+	got := strings.TrimSpace(out.String())
+	want := `{"Age":3,"Name":"a"}`
+	if got != want {
+		t.Fatalf("Select(\"*\") dropped fields: got %s, want %s", got, want)
+	}
+}
+
+func TestSelectKeepsOnlyNamedFields(t *testing.T) {
+	in := strings.NewReader(`{"Name":"a","Age":3}` + "\n")
+	var out strings.Builder
+
+	if _, err := New(in, &out).Select("Name").Run(context.Background()); err != nil {
+		t.Fatalf("Run returned error: %v", err)
+	}
+
+	got := strings.TrimSpace(out.String())
+	want := `{"Name":"a"}`
+	if got != want {
+		t.Fatalf("Select(\"Name\") = %s, want %s", got, want)
+	}
+}
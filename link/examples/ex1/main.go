@@ -1,9 +1,15 @@
 package main
 
 import (
+	"context"
+	"encoding/xml"
+	"flag"
 	"fmt"
-	"gophercises/link"
+	"log"
 	"strings"
+
+	"gophercises/link"
+	"gophercises/sitemap"
 )
 
 var exampleHtml = `
@@ -17,6 +23,28 @@ var exampleHtml = `
 `
 
 func main() {
+	sitemapURL := flag.String("sitemap", "", "if set, crawl this URL and write a sitemap.xml to stdout instead of running the link.Parse example")
+	maxDepth := flag.Int("depth", 3, "maximum crawl depth when -sitemap is set")
+	workers := flag.Int("workers", 4, "crawl concurrency when -sitemap is set")
+	flag.Parse()
+
+	if *sitemapURL != "" {
+		set, err := sitemap.Build(context.Background(), *sitemapURL, sitemap.Options{
+			MaxDepth:         *maxDepth,
+			Workers:          *workers,
+			RespectRobotsTxt: true,
+		})
+		if err != nil {
+			log.Fatalln(err)
+		}
+		out, err := xml.MarshalIndent(set, "", "  ")
+		if err != nil {
+			log.Fatalln(err)
+		}
+		fmt.Println(xml.Header + string(out))
+		return
+	}
+
 	r := strings.NewReader(exampleHtml)
 	links, err := link.Parse(r)
 	if err != nil {
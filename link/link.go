@@ -0,0 +1,93 @@
+// Package link extracts <a href> links out of an HTML document, the
+// exercise behind the ex1 example in link/examples.
+package link
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// Link is one <a> tag found while parsing an HTML document.
+type Link struct {
+	Href string
+	Text string
+}
+
+// Parse walks r as an HTML document and returns every <a> tag found in
+// it, in document order.
+func Parse(r io.Reader) ([]Link, error) {
+	doc, err := html.Parse(r)
+	if err != nil {
+		return nil, fmt.Errorf("link: failed to parse HTML: %w", err)
+	}
+	return Links(doc), nil
+}
+
+// Links returns every <a> tag found in doc, an HTML document already
+// parsed with html.Parse. Callers that also need Base(doc) should parse
+// once and call both instead of calling Parse, which only has the
+// links.
+func Links(doc *html.Node) []Link {
+	var links []Link
+	walk(doc, &links)
+	return links
+}
+
+// Base returns the href of doc's <base> element, or "" if it has none.
+// When present, relative hrefs elsewhere in the document should be
+// resolved against it instead of against the document's own URL.
+func Base(doc *html.Node) string {
+	var href string
+	var find func(n *html.Node) bool
+	find = func(n *html.Node) bool {
+		if n.Type == html.ElementNode && n.Data == "base" {
+			href = attr(n, "href")
+			return true
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if find(c) {
+				return true
+			}
+		}
+		return false
+	}
+	find(doc)
+	return href
+}
+
+func walk(n *html.Node, links *[]Link) {
+	if n.Type == html.ElementNode && n.Data == "a" {
+		*links = append(*links, Link{
+			Href: attr(n, "href"),
+			Text: strings.TrimSpace(text(n)),
+		})
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		walk(c, links)
+	}
+}
+
+// text concatenates the text content of n and its descendants.
+func text(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+	var sb strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		sb.WriteString(text(c))
+		sb.WriteString(" ")
+	}
+	return sb.String()
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
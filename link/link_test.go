@@ -0,0 +1,33 @@
+package link
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/net/html"
+)
+
+func TestBaseReturnsHrefOfBaseElement(t *testing.T) {
+	doc, err := html.Parse(strings.NewReader(`
+		<html><head><base href="https://example.com/docs/"></head>
+		<body><a href="guide.html">Guide</a></body></html>
+	`))
+	if err != nil {
+		t.Fatalf("failed to parse test document: %v", err)
+	}
+
+	if got, want := Base(doc), "https://example.com/docs/"; got != want {
+		t.Fatalf("Base() = %q, want %q", got, want)
+	}
+}
+
+func TestBaseReturnsEmptyStringWithoutBaseElement(t *testing.T) {
+	doc, err := html.Parse(strings.NewReader(`<html><body><a href="guide.html">Guide</a></body></html>`))
+	if err != nil {
+		t.Fatalf("failed to parse test document: %v", err)
+	}
+
+	if got := Base(doc); got != "" {
+		t.Fatalf("Base() = %q, want empty string", got)
+	}
+}
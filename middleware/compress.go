@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"compress/flate"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Compress negotiates gzip or deflate encoding from the request's
+// Accept-Encoding header and transparently compresses the response body
+// written by the wrapped handler.
+func Compress(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch negotiateEncoding(r.Header.Get("Accept-Encoding")) {
+		case "gzip":
+			gw := gzip.NewWriter(w)
+			defer gw.Close()
+			w.Header().Set("Content-Encoding", "gzip")
+			next.ServeHTTP(&compressingWriter{ResponseWriter: w, w: gw}, r)
+		case "deflate":
+			fw, err := flate.NewWriter(w, flate.DefaultCompression)
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			defer fw.Close()
+			w.Header().Set("Content-Encoding", "deflate")
+			next.ServeHTTP(&compressingWriter{ResponseWriter: w, w: fw}, r)
+		default:
+			next.ServeHTTP(w, r)
+		}
+	})
+}
+
+// negotiateEncoding picks gzip over deflate when both are acceptable,
+// matching the preference order most servers use.
+func negotiateEncoding(acceptEncoding string) string {
+	for _, enc := range strings.Split(acceptEncoding, ",") {
+		switch strings.TrimSpace(strings.SplitN(enc, ";", 2)[0]) {
+		case "gzip":
+			return "gzip"
+		}
+	}
+	for _, enc := range strings.Split(acceptEncoding, ",") {
+		switch strings.TrimSpace(strings.SplitN(enc, ";", 2)[0]) {
+		case "deflate":
+			return "deflate"
+		}
+	}
+	return ""
+}
+
+// compressingWriter routes Write calls through a compress/gzip or
+// compress/flate writer instead of straight to the underlying
+// http.ResponseWriter.
+type compressingWriter struct {
+	http.ResponseWriter
+	w io.Writer
+}
+
+// WriteHeader strips any Content-Length the wrapped handler set before
+// it reaches the client: that length was computed for the uncompressed
+// body, and sending it alongside the compressed body written through w
+// truncates or corrupts the response.
+func (c *compressingWriter) WriteHeader(statusCode int) {
+	c.Header().Del("Content-Length")
+	c.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (c *compressingWriter) Write(b []byte) (int, error) {
+	c.Header().Del("Content-Length")
+	return c.w.Write(b)
+}
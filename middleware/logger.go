@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"net/http"
+)
+
+// Logger logs each request as a structured JSON record via logrus,
+// including status, response size, latency, and route, once the
+// wrapped handler has finished. It relies on WrapResponseWriter to
+// observe the status and byte count without interfering with
+// http.Flusher/http.Hijacker.
+func Logger(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		ww := WrapResponseWriter(w)
+
+		next.ServeHTTP(ww, r)
+
+		logrus.WithFields(logrus.Fields{
+			"request_id": RequestIDFromContext(r.Context()),
+			"method":     r.Method,
+			"route":      r.URL.Path,
+			"status":     ww.Status(),
+			"bytes":      ww.BytesWritten(),
+			"latency_ms": time.Since(start).Milliseconds(),
+			"remote_addr": r.RemoteAddr,
+		}).Info("handled request")
+	})
+}
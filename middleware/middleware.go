@@ -0,0 +1,21 @@
+// Package middleware provides a small chi-style middleware chain for the
+// raw net/http ServeMux demo in request_handling, so handlers registered
+// with mux.Handle can compose cross-cutting behavior like request IDs,
+// logging, and panic recovery instead of reimplementing it per handler.
+package middleware
+
+import "net/http"
+
+// Middleware wraps an http.Handler with additional behavior.
+type Middleware func(http.Handler) http.Handler
+
+// Chain composes mw into a single Middleware that applies them in the
+// order given, so Chain(A, B)(h) behaves as A(B(h)).
+func Chain(mw ...Middleware) Middleware {
+	return func(h http.Handler) http.Handler {
+		for i := len(mw) - 1; i >= 0; i-- {
+			h = mw[i](h)
+		}
+		return h
+	}
+}
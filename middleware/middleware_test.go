@@ -0,0 +1,102 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRecovererRecoversPanics(t *testing.T) {
+	handler := Recoverer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("expected status %d, got %d", http.StatusInternalServerError, rec.Code)
+	}
+}
+
+func TestCompressNegotiatesGzipForLargeBodies(t *testing.T) {
+	body := strings.Repeat("hello world ", 1000)
+	handler := Compress(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(body))
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if got := rec.Header().Get("Content-Encoding"); got != "gzip" {
+		t.Fatalf("expected Content-Encoding: gzip, got %q", got)
+	}
+
+	gr, err := gzip.NewReader(rec.Body)
+	if err != nil {
+		t.Fatalf("failed to open gzip reader: %v", err)
+	}
+	defer gr.Close()
+
+	decoded, err := io.ReadAll(gr)
+	if err != nil {
+		t.Fatalf("failed to read gzip body: %v", err)
+	}
+	if string(decoded) != body {
+		t.Fatalf("decoded gzip body did not round-trip")
+	}
+}
+
+func TestCompressStripsContentLengthSetByHandler(t *testing.T) {
+	body := strings.Repeat("hello world ", 1000)
+	handler := Compress(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", fmt.Sprint(len(body)))
+		w.Write([]byte(body))
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if got := rec.Header().Get("Content-Length"); got != "" {
+		t.Fatalf("expected Content-Length to be stripped, got %q", got)
+	}
+
+	gr, err := gzip.NewReader(rec.Body)
+	if err != nil {
+		t.Fatalf("failed to open gzip reader: %v", err)
+	}
+	defer gr.Close()
+
+	decoded, err := io.ReadAll(gr)
+	if err != nil {
+		t.Fatalf("failed to read gzip body: %v", err)
+	}
+	if string(decoded) != body {
+		t.Fatalf("decoded gzip body did not round-trip")
+	}
+}
+
+func TestRealIPUsesXForwardedFor(t *testing.T) {
+	var gotRemoteAddr string
+	handler := RealIP(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotRemoteAddr = r.RemoteAddr
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "10.0.0.1:12345"
+	req.Header.Set("X-Forwarded-For", "203.0.113.9, 10.0.0.2")
+
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	if gotRemoteAddr != "203.0.113.9" {
+		t.Fatalf("expected RemoteAddr to be rewritten to %q, got %q", "203.0.113.9", gotRemoteAddr)
+	}
+}
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+)
+
+// RealIP overwrites r.RemoteAddr with the client address reported by the
+// X-Forwarded-For or X-Real-IP headers, when present, so that handlers
+// and logging middleware downstream see the original client rather than
+// the last proxy hop. Only trust this behind a proxy you control.
+func RealIP(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ip := realIP(r); ip != "" {
+			r.RemoteAddr = ip
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func realIP(r *http.Request) string {
+	if xff := r.Header.Get("X-Forwarded-For"); xff != "" {
+		parts := strings.Split(xff, ",")
+		return strings.TrimSpace(parts[0])
+	}
+	return r.Header.Get("X-Real-Ip")
+}
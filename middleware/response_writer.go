@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"net/http"
+)
+
+// WrapResponseWriter wraps w to capture the status code and byte count
+// written to it, for use by middleware like Logger, without breaking
+// http.Flusher or http.Hijacker if the underlying w supports them.
+func WrapResponseWriter(w http.ResponseWriter) *ResponseWriter {
+	return &ResponseWriter{ResponseWriter: w, status: http.StatusOK}
+}
+
+// ResponseWriter is an http.ResponseWriter that records the status code
+// and number of bytes written for later inspection.
+type ResponseWriter struct {
+	http.ResponseWriter
+	status      int
+	bytes       int
+	wroteHeader bool
+}
+
+// Status returns the status code written, or http.StatusOK if
+// WriteHeader was never called explicitly.
+func (w *ResponseWriter) Status() int { return w.status }
+
+// BytesWritten returns the number of body bytes written so far.
+func (w *ResponseWriter) BytesWritten() int { return w.bytes }
+
+func (w *ResponseWriter) WriteHeader(status int) {
+	if w.wroteHeader {
+		return
+	}
+	w.status = status
+	w.wroteHeader = true
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *ResponseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	n, err := w.ResponseWriter.Write(b)
+	w.bytes += n
+	return n, err
+}
+
+// Flush implements http.Flusher if the wrapped ResponseWriter does.
+func (w *ResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack implements http.Hijacker if the wrapped ResponseWriter does.
+func (w *ResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hj, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("middleware: underlying ResponseWriter does not support Hijack")
+	}
+	return hj.Hijack()
+}
@@ -0,0 +1,64 @@
+package quiz1
+
+import "time"
+
+// Problem is one question/answer pair loaded from a quiz CSV file.
+type Problem struct {
+	Question string
+	Answer   string
+}
+
+// Result is the outcome of submitting an answer to the current problem.
+type Result struct {
+	Correct bool
+}
+
+// QuizEngine runs the question/answer/scoring loop of a quiz,
+// independent of how problems are presented or answers collected. The
+// cli, web, and tui frontends each drive the same QuizEngine.
+type QuizEngine struct {
+	problems []Problem
+	index    int
+	correct  int
+	deadline time.Time
+}
+
+// NewQuizEngine returns a QuizEngine over problems with the overall
+// quiz deadline set timeLimit from now.
+func NewQuizEngine(problems []Problem, timeLimit time.Duration) *QuizEngine {
+	return &QuizEngine{
+		problems: problems,
+		deadline: time.Now().Add(timeLimit),
+	}
+}
+
+// Next returns the next unanswered problem. ok is false once every
+// problem has been returned.
+func (e *QuizEngine) Next() (Problem, bool) {
+	if e.index >= len(e.problems) {
+		return Problem{}, false
+	}
+	p := e.problems[e.index]
+	e.index++
+	return p, true
+}
+
+// Submit grades answer against the problem Next most recently returned.
+func (e *QuizEngine) Submit(answer string) Result {
+	correct := e.index > 0 && e.index <= len(e.problems) && answer == e.problems[e.index-1].Answer
+	if correct {
+		e.correct++
+	}
+	return Result{Correct: correct}
+}
+
+// Score returns the number of correct answers so far and the total
+// number of problems in the quiz.
+func (e *QuizEngine) Score() (correct, total int) {
+	return e.correct, len(e.problems)
+}
+
+// Deadline returns the time the quiz as a whole expires.
+func (e *QuizEngine) Deadline() time.Time {
+	return e.deadline
+}
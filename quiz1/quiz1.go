@@ -9,28 +9,56 @@ import (
 	"time"
 )
 
+// RunQuiz1 loads the quiz CSV and runs it through the frontend selected
+// by -mode: "cli" (the original stdin/stdout loop), "web" (problems
+// streamed to the browser over a websocket), or "tui" (an alt-screen
+// terminal renderer with a countdown bar). All three share the same
+// QuizEngine and CSV loader.
 func RunQuiz1() {
 	csvFileName := flag.String("csv", "quiz1/problems.csv", "a csv file in the format of question, answer")
 	timeLimit := flag.Int("limit", 2, "the time limit for the quiz in seconds")
+	mode := flag.String("mode", "cli", "the frontend to run the quiz with: cli, web, or tui")
+	addr := flag.String("addr", ":8080", "address to listen on for -mode web")
 	flag.Parse()
 
-	file, err := os.Open(*csvFileName)
+	problems, err := loadProblems(*csvFileName)
 	if err != nil {
-		exit(fmt.Sprintf("Failed to open the CSV file: %s\n", *csvFileName))
+		exit(err.Error())
 	}
-	reader := csv.NewReader(file)
-	lines, err := reader.ReadAll()
-	if err != nil {
-		exit("Failed to parse the provided CSV file.")
+
+	limit := time.Duration(*timeLimit) * time.Second
+
+	switch *mode {
+	case "cli":
+		runCLI(NewQuizEngine(problems, limit), limit)
+	case "web":
+		if err := runWeb(problems, limit, *addr); err != nil {
+			exit(err.Error())
+		}
+	case "tui":
+		if err := runTUI(NewQuizEngine(problems, limit), limit); err != nil {
+			exit(err.Error())
+		}
+	default:
+		exit(fmt.Sprintf("Unknown -mode %q, expected cli, web, or tui.", *mode))
 	}
-	problems := parseLines(lines)
+}
+
+// runCLI is the original quiz1 stdin/stdout loop, now driven through
+// QuizEngine instead of looping over the CSV rows directly.
+func runCLI(engine *QuizEngine, perProblemLimit time.Duration) {
+	timer := time.NewTimer(perProblemLimit)
 
-	timer := time.NewTimer(time.Duration(*timeLimit) * time.Second)
-	correct := 0
+	i := 0
+problemLoop:
+	for {
+		p, ok := engine.Next()
+		if !ok {
+			break
+		}
+		i++
+		fmt.Printf("Problem #%d: %s = ", i, p.Question)
 
-	problemLoop:
-	for i, p := range problems {
-		fmt.Printf("Problem #%d: %s = ", i + 1, p.question)
 		answerCh := make(chan string)
 		go func() {
 			var answer string
@@ -41,32 +69,44 @@ func RunQuiz1() {
 			answerCh <- answer
 		}()
 		select {
-		case <- timer.C:
+		case <-timer.C:
 			fmt.Println()
 			break problemLoop
-		case answer := <- answerCh:
-			if answer == p.answer {
-				correct++
-			}
+		case answer := <-answerCh:
+			engine.Submit(answer)
 		}
+		timer.Reset(perProblemLimit)
+	}
+
+	correct, total := engine.Score()
+	fmt.Printf("You scored %d out of %d.\n", correct, total)
+}
+
+// loadProblems reads and parses a quiz CSV file in the "question,
+// answer" format quiz1 has always used.
+func loadProblems(csvFileName string) ([]Problem, error) {
+	file, err := os.Open(csvFileName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the CSV file: %s", csvFileName)
+	}
+	defer file.Close()
+
+	lines, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the provided CSV file")
 	}
-	fmt.Printf("You scored %d out of %d.\n", correct, len(problems))
+	return parseLines(lines), nil
 }
 
-func parseLines(lines [][]string) []problem {
-	ret := make([]problem, len(lines))
+func parseLines(lines [][]string) []Problem {
+	ret := make([]Problem, len(lines))
 	for i, line := range lines {
-		ret[i] = problem{question:strings.TrimSpace(line[0]), answer:strings.TrimSpace(line[1])}
+		ret[i] = Problem{Question: strings.TrimSpace(line[0]), Answer: strings.TrimSpace(line[1])}
 	}
 	return ret
 }
 
-type problem struct {
-	question string
-	answer string
-}
-
 func exit(msg string) {
 	fmt.Println(msg)
 	os.Exit(1)
-}
\ No newline at end of file
+}
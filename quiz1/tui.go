@@ -0,0 +1,104 @@
+package quiz1
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	altScreenEnter = "\x1b[?1049h"
+	altScreenExit  = "\x1b[?1049l"
+	clearScreen    = "\x1b[2J\x1b[H"
+	barWidth       = 30
+)
+
+// runTUI renders the quiz on an alt-screen with a countdown bar against
+// a fresh perProblemLimit deadline for each problem, redrawing as the
+// clock runs down while it waits for an answer on stdin. stdin is
+// scanned by a single goroutine that outlives every problem, so a
+// problem that times out never leaves a scanner.Scan() call racing the
+// next problem's.
+func runTUI(engine *QuizEngine, perProblemLimit time.Duration) error {
+	fmt.Print(altScreenEnter)
+	defer fmt.Print(altScreenExit)
+
+	scanner := bufio.NewScanner(os.Stdin)
+	answers := make(chan string)
+	go func() {
+		for scanner.Scan() {
+			answers <- scanner.Text()
+		}
+		close(answers)
+	}()
+
+	index := 0
+
+	for {
+		p, ok := engine.Next()
+		if !ok {
+			break
+		}
+		index++
+
+		deadline := time.Now().Add(perProblemLimit)
+		ticker := time.NewTicker(100 * time.Millisecond)
+	answerLoop:
+		for {
+			correct, total := engine.Score()
+			drawTUIFrame(index, p, deadline, perProblemLimit, correct, total)
+
+			select {
+			case answer, open := <-answers:
+				if open {
+					engine.Submit(answer)
+				}
+				ticker.Stop()
+				break answerLoop
+			case <-ticker.C:
+				if time.Now().After(deadline) {
+					ticker.Stop()
+					break answerLoop
+				}
+			}
+		}
+	}
+
+	correct, total := engine.Score()
+	fmt.Print(clearScreen)
+	fmt.Printf("You scored %d out of %d.\n", correct, total)
+	return nil
+}
+
+// drawTUIFrame redraws the whole alt-screen: the current problem, a
+// countdown bar of the time left before deadline (full at limit), and
+// the running score.
+func drawTUIFrame(index int, p Problem, deadline time.Time, limit time.Duration, correct, total int) {
+	remaining := time.Until(deadline)
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	fmt.Print(clearScreen)
+	fmt.Printf("Problem #%d: %s = \n\n", index, p.Question)
+	fmt.Printf("[%s] %5.1fs\n\n", countdownBar(remaining, limit), remaining.Seconds())
+	fmt.Printf("Score: %d/%d\n", correct, total)
+}
+
+// countdownBar renders a filled/empty bar of barWidth characters
+// showing remaining against a full-bar reference duration of total.
+func countdownBar(remaining, total time.Duration) string {
+	if total <= 0 {
+		total = time.Second
+	}
+	filled := int(float64(barWidth) * float64(remaining) / float64(total))
+	if filled > barWidth {
+		filled = barWidth
+	}
+	if filled < 0 {
+		filled = 0
+	}
+	return strings.Repeat("#", filled) + strings.Repeat(" ", barWidth-filled)
+}
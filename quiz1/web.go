@@ -0,0 +1,146 @@
+package quiz1
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// wsMessage is the JSON envelope exchanged over the web frontend's
+// websocket connection in both directions.
+type wsMessage struct {
+	Type     string `json:"type"`
+	Index    int    `json:"index,omitempty"`
+	Question string `json:"question,omitempty"`
+	Answer   string `json:"answer,omitempty"`
+	Correct  bool   `json:"correct,omitempty"`
+	Deadline int64  `json:"deadline,omitempty"`
+	Score    *score `json:"score,omitempty"`
+}
+
+type score struct {
+	Correct int `json:"correct"`
+	Total   int `json:"total"`
+}
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+}
+
+// runWeb serves the quiz as a web frontend: problems are streamed one
+// per websocket message with a live deadline, answers are posted back
+// over the same connection, and the score updates progressively as
+// each answer comes in. Each websocket connection gets its own
+// QuizEngine built from problems and timeLimit, so concurrent tabs or a
+// mid-quiz reconnect each run an independent, unsynchronized quiz
+// instead of racing on shared engine state.
+func runWeb(problems []Problem, timeLimit time.Duration, addr string) error {
+	log.Printf("Listening on %s for the quiz1 web frontend...\n", addr)
+	return http.ListenAndServe(addr, newQuizMux(problems, timeLimit))
+}
+
+// newQuizMux builds the handler runWeb serves, split out so tests can
+// drive it through httptest.NewServer without binding a real port.
+func newQuizMux(problems []Problem, timeLimit time.Duration) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", serveQuizPage)
+	mux.HandleFunc("/ws", func(w http.ResponseWriter, r *http.Request) {
+		serveQuizWS(w, r, NewQuizEngine(problems, timeLimit))
+	})
+	return mux
+}
+
+// serveQuizWS upgrades the request to a websocket and drives engine,
+// which is private to this connection, until every problem has been
+// answered or the connection closes.
+func serveQuizWS(w http.ResponseWriter, r *http.Request, engine *QuizEngine) {
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	for {
+		p, ok := engine.Next()
+		if !ok {
+			break
+		}
+
+		if err := conn.WriteJSON(wsMessage{
+			Type:     "problem",
+			Question: p.Question,
+			Deadline: engine.Deadline().UnixMilli(),
+		}); err != nil {
+			return
+		}
+
+		var in wsMessage
+		if err := conn.ReadJSON(&in); err != nil {
+			return
+		}
+
+		result := engine.Submit(in.Answer)
+		correct, total := engine.Score()
+		if err := conn.WriteJSON(wsMessage{
+			Type:    "result",
+			Correct: result.Correct,
+			Score:   &score{Correct: correct, Total: total},
+		}); err != nil {
+			return
+		}
+	}
+
+	correct, total := engine.Score()
+	_ = conn.WriteJSON(wsMessage{Type: "done", Score: &score{Correct: correct, Total: total}})
+}
+
+// serveQuizPage serves a minimal page that connects to /ws, renders a
+// live countdown against the problem's deadline, and shows the
+// progressive score as answers come back.
+func serveQuizPage(w http.ResponseWriter, r *http.Request) {
+	fmt.Fprint(w, quizPageHTML)
+}
+
+const quizPageHTML = `<!doctype html>
+<html>
+<head><title>quiz1</title></head>
+<body>
+	<div id="question"></div>
+	<div id="timer"></div>
+	<div id="score"></div>
+	<input id="answer" autofocus>
+	<script>
+	var ws = new WebSocket("ws://" + location.host + "/ws");
+	var deadline = 0;
+	ws.onmessage = function(ev) {
+		var msg = JSON.parse(ev.data);
+		if (msg.type === "problem") {
+			deadline = msg.deadline;
+			document.getElementById("question").textContent = msg.question;
+		} else if (msg.type === "result") {
+			document.getElementById("score").textContent =
+				msg.score.correct + " / " + msg.score.total;
+		} else if (msg.type === "done") {
+			document.getElementById("question").textContent =
+				"Done! " + msg.score.correct + " / " + msg.score.total;
+		}
+	};
+	document.getElementById("answer").addEventListener("keydown", function(ev) {
+		if (ev.key === "Enter") {
+			ws.send(JSON.stringify({answer: ev.target.value}));
+			ev.target.value = "";
+		}
+	});
+	setInterval(function() {
+		if (!deadline) return;
+		var remaining = Math.max(0, Math.round((deadline - Date.now()) / 1000));
+		document.getElementById("timer").textContent = remaining + "s left";
+	}, 250);
+	</script>
+</body>
+</html>
+`
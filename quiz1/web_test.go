@@ -0,0 +1,97 @@
+package quiz1
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestWebModeStreamsProblemsAndScoresAnswers(t *testing.T) {
+	problems := []Problem{
+		{Question: "1+1", Answer: "2"},
+		{Question: "2+2", Answer: "4"},
+	}
+
+	srv := httptest.NewServer(newQuizMux(problems, 5*time.Second))
+	defer srv.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(srv.URL, "http") + "/ws"
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	if err != nil {
+		t.Fatalf("failed to dial websocket: %v", err)
+	}
+	defer conn.Close()
+
+	answers := map[string]string{"1+1": "2", "2+2": "wrong"}
+	wantCorrect := 0
+	gotCorrect := 0
+
+	for i := 0; i < len(problems); i++ {
+		var problem wsMessage
+		if err := conn.ReadJSON(&problem); err != nil {
+			t.Fatalf("failed to read problem: %v", err)
+		}
+		if problem.Type != "problem" {
+			t.Fatalf("expected message type %q, got %q", "problem", problem.Type)
+		}
+
+		answer := answers[problem.Question]
+		if answer == "2" || answer == "4" {
+			wantCorrect++
+		}
+		if err := conn.WriteJSON(wsMessage{Answer: answer}); err != nil {
+			t.Fatalf("failed to send answer: %v", err)
+		}
+
+		var result wsMessage
+		if err := conn.ReadJSON(&result); err != nil {
+			t.Fatalf("failed to read result: %v", err)
+		}
+		if result.Type != "result" {
+			t.Fatalf("expected message type %q, got %q", "result", result.Type)
+		}
+		if result.Correct {
+			gotCorrect++
+		}
+	}
+
+	var done wsMessage
+	if err := conn.ReadJSON(&done); err != nil {
+		t.Fatalf("failed to read done message: %v", err)
+	}
+	if done.Type != "done" {
+		t.Fatalf("expected message type %q, got %q", "done", done.Type)
+	}
+	if done.Score.Correct != wantCorrect || done.Score.Total != len(problems) {
+		t.Fatalf("expected final score %d/%d, got %d/%d", wantCorrect, len(problems), done.Score.Correct, done.Score.Total)
+	}
+	if gotCorrect != wantCorrect {
+		t.Fatalf("expected %d correct answers counted live, got %d", wantCorrect, gotCorrect)
+	}
+}
+
+func TestWebModeConcurrentConnectionsHaveIndependentEngines(t *testing.T) {
+	problems := []Problem{{Question: "1+1", Answer: "2"}}
+	srv := httptest.NewServer(newQuizMux(problems, 5*time.Second))
+	defer srv.Close()
+	wsURL := "ws" + strings.TrimPrefix(srv.URL, "http") + "/ws"
+
+	for i := 0; i < 2; i++ {
+		conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+		if err != nil {
+			t.Fatalf("failed to dial websocket: %v", err)
+		}
+
+		var problem wsMessage
+		if err := conn.ReadJSON(&problem); err != nil {
+			t.Fatalf("failed to read problem: %v", err)
+		}
+		if problem.Question != "1+1" {
+			t.Fatalf("expected a fresh engine to serve the first problem again, got %q", problem.Question)
+		}
+		conn.Close()
+	}
+}
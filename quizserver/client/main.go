@@ -0,0 +1,77 @@
+// Command quizclient is a small CLI that drives a running quizserver
+// over a real gRPC connection, the same way quiz1.RunQuiz1 used to
+// drive the CSV loop directly, except the problems now arrive over the
+// network via the StartQuiz stream.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"gophercises/quizserver/quizpb"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:9000", "address of the quizserver gRPC listener")
+	quizName := flag.String("quiz", "quiz1", "name of the quiz to run")
+	timeLimit := flag.Int("limit", 2, "the time limit for each problem in seconds")
+	flag.Parse()
+
+	conn, err := grpc.NewClient(*addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.CallContentSubtype(quizpb.CodecName)),
+	)
+	if err != nil {
+		log.Fatalf("failed to dial %s: %v", *addr, err)
+	}
+	defer conn.Close()
+
+	client := quizpb.NewQuizServiceClient(conn)
+	ctx := context.Background()
+
+	stream, err := client.StartQuiz(ctx, &quizpb.QuizConfig{
+		QuizName:         *quizName,
+		TimeLimitSeconds: int32(*timeLimit),
+	})
+	if err != nil {
+		log.Fatalf("failed to start quiz: %v", err)
+	}
+
+	correct, total := 0, 0
+	for {
+		p, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatalf("stream error: %v", err)
+		}
+		total++
+
+		fmt.Printf("Problem #%d: %s = ", p.Index+1, p.Question)
+		var answer string
+		if _, err := fmt.Scanf("%s\n", &answer); err != nil {
+			fmt.Println("Failed to scan input!")
+		}
+
+		result, err := client.SubmitAnswer(ctx, &quizpb.AnswerRequest{
+			SessionId: p.SessionId,
+			Index:     p.Index,
+			Answer:    answer,
+		})
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		if result.Correct {
+			correct++
+		}
+	}
+	fmt.Printf("You scored %d out of %d.\n", correct, total)
+}
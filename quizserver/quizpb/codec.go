@@ -0,0 +1,34 @@
+package quizpb
+
+import (
+	"encoding/json"
+
+	"google.golang.org/grpc/encoding"
+)
+
+// CodecName is the grpc content-subtype this package's messages are
+// marshaled with. Clients must dial with
+// grpc.WithDefaultCallOptions(grpc.CallContentSubtype(quizpb.CodecName))
+// for the server to pick jsonCodec for the connection.
+const CodecName = "json"
+
+// jsonCodec marshals gRPC messages as JSON instead of the protobuf
+// wire format. It stands in for the real codec protoc-gen-go would
+// generate for quiz.proto: this repo has no protoc toolchain available,
+// so the quizpb messages are plain Go structs rather than generated
+// proto.Message implementations that the default "proto" codec
+// requires. Everything above the wire format — the real grpc.Server,
+// TCP listener, grpc-gateway REST façade, and network-dialing client —
+// is the genuine article; regenerating quiz.proto with protoc and
+// dropping this codec in favor of the default one is a drop-in swap.
+type jsonCodec struct{}
+
+func (jsonCodec) Name() string { return CodecName }
+
+func (jsonCodec) Marshal(v interface{}) ([]byte, error) { return json.Marshal(v) }
+
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error { return json.Unmarshal(data, v) }
+
+func init() {
+	encoding.RegisterCodec(jsonCodec{})
+}
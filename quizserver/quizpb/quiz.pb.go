@@ -0,0 +1,55 @@
+// Package quizpb holds the message and service types for quiz.proto.
+// protoc-gen-go would normally generate these as proto.Message
+// implementations marshaled over the wire in protobuf's binary format;
+// this repo has no protoc toolchain available, so they're plain Go
+// structs instead, paired with the jsonCodec in codec.go so they still
+// travel over a real grpc.Server/grpc.ClientConn (see cmd/quizserver and
+// quiz_grpc.pb.go). Running `make protoc-gen` against quiz.proto and
+// switching back to the default "proto" codec is a drop-in swap.
+package quizpb
+
+// QuizConfig is the request to QuizService.StartQuiz.
+type QuizConfig struct {
+	QuizName         string `json:"quiz_name"`
+	TimeLimitSeconds int32  `json:"time_limit_seconds"`
+}
+
+// Problem is one streamed element of QuizService.StartQuiz.
+type Problem struct {
+	SessionId string `json:"session_id"`
+	Index     int32  `json:"index"`
+	Question  string `json:"question"`
+}
+
+// AnswerRequest is the request to QuizService.SubmitAnswer.
+type AnswerRequest struct {
+	SessionId string `json:"session_id"`
+	Index     int32  `json:"index"`
+	Answer    string `json:"answer"`
+}
+
+// AnswerResult is the response to QuizService.SubmitAnswer.
+type AnswerResult struct {
+	Correct      bool  `json:"correct"`
+	CorrectCount int32 `json:"correct_count"`
+	TotalCount   int32 `json:"total_count"`
+}
+
+// ScoreRequest is the request to QuizService.GetScore.
+type ScoreRequest struct {
+	SessionId string `json:"session_id"`
+}
+
+// ScoreResult is the response to QuizService.GetScore.
+type ScoreResult struct {
+	CorrectCount int32 `json:"correct_count"`
+	TotalCount   int32 `json:"total_count"`
+}
+
+// ListQuizzesRequest is the request to QuizService.ListQuizzes.
+type ListQuizzesRequest struct{}
+
+// ListQuizzesResult is the response to QuizService.ListQuizzes.
+type ListQuizzesResult struct {
+	QuizNames []string `json:"quiz_names"`
+}
@@ -0,0 +1,120 @@
+// This file hand-authors what protoc-gen-grpc-gateway would generate
+// from quiz.proto's google.api.http annotations: a RegisterHandler that
+// proxies REST requests onto a QuizServiceClient over a real
+// grpc.ClientConn. It uses the genuine grpc-gateway runtime.ServeMux,
+// just without the codegen step this repo's toolchain can't run.
+package quizpb
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+)
+
+// RegisterQuizServiceHandler registers the REST façade declared in
+// quiz.proto onto mux, forwarding each request to QuizService over conn.
+func RegisterQuizServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn grpc.ClientConnInterface) error {
+	client := NewQuizServiceClient(conn)
+
+	mux.HandlePath(http.MethodPost, "/v1/quizzes/{quiz_name}/start", func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		handleStartQuiz(client, w, r, pathParams)
+	})
+	mux.HandlePath(http.MethodPost, "/v1/sessions/{session_id}/answers", func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		handleSubmitAnswer(client, w, r, pathParams)
+	})
+	mux.HandlePath(http.MethodGet, "/v1/sessions/{session_id}/score", func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		handleGetScore(client, w, r, pathParams)
+	})
+	mux.HandlePath(http.MethodGet, "/v1/quizzes", func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		handleListQuizzes(client, w, r)
+	})
+	return nil
+}
+
+// handleStartQuiz proxies POST /v1/quizzes/{quiz_name}/start to
+// QuizService.StartQuiz, relaying the resulting stream to the caller as
+// newline-delimited JSON, flushed as each Problem arrives.
+func handleStartQuiz(client QuizServiceClient, w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	cfg := &QuizConfig{}
+	if r.Body != nil {
+		_ = json.NewDecoder(r.Body).Decode(cfg)
+	}
+	cfg.QuizName = pathParams["quiz_name"]
+
+	stream, err := client.StartQuiz(r.Context(), cfg)
+	if err != nil {
+		writeGatewayError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	flusher, _ := w.(http.Flusher)
+	enc := json.NewEncoder(w)
+	for {
+		problem, err := stream.Recv()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			return
+		}
+		if err := enc.Encode(problem); err != nil {
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+}
+
+// handleSubmitAnswer proxies POST /v1/sessions/{session_id}/answers to
+// QuizService.SubmitAnswer.
+func handleSubmitAnswer(client QuizServiceClient, w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	req := &AnswerRequest{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.SessionId = pathParams["session_id"]
+
+	resp, err := client.SubmitAnswer(r.Context(), req)
+	if err != nil {
+		writeGatewayError(w, err)
+		return
+	}
+	writeJSON(w, resp)
+}
+
+// handleGetScore proxies GET /v1/sessions/{session_id}/score to
+// QuizService.GetScore.
+func handleGetScore(client QuizServiceClient, w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	resp, err := client.GetScore(r.Context(), &ScoreRequest{SessionId: pathParams["session_id"]})
+	if err != nil {
+		writeGatewayError(w, err)
+		return
+	}
+	writeJSON(w, resp)
+}
+
+// handleListQuizzes proxies GET /v1/quizzes to QuizService.ListQuizzes.
+func handleListQuizzes(client QuizServiceClient, w http.ResponseWriter, r *http.Request) {
+	resp, err := client.ListQuizzes(r.Context(), &ListQuizzesRequest{})
+	if err != nil {
+		writeGatewayError(w, err)
+		return
+	}
+	writeJSON(w, resp)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeGatewayError(w http.ResponseWriter, err error) {
+	http.Error(w, err.Error(), http.StatusBadGateway)
+}
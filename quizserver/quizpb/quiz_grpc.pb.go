@@ -0,0 +1,215 @@
+// This file hand-authors what protoc-gen-go-grpc would generate from
+// quiz.proto's QuizService: a real grpc.ClientConnInterface-based
+// client, a grpc.ServiceDesc wiring QuizServiceServer to a grpc.Server,
+// and the per-method handler glue in between. The only thing standing
+// in for codegen is the wire codec (see codec.go) — everything here
+// talks to an actual grpc.Server over an actual network connection.
+package quizpb
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+const (
+	QuizService_StartQuiz_FullMethodName    = "/quizpb.QuizService/StartQuiz"
+	QuizService_SubmitAnswer_FullMethodName = "/quizpb.QuizService/SubmitAnswer"
+	QuizService_GetScore_FullMethodName     = "/quizpb.QuizService/GetScore"
+	QuizService_ListQuizzes_FullMethodName  = "/quizpb.QuizService/ListQuizzes"
+)
+
+// QuizServiceClient is the client API for QuizService.
+type QuizServiceClient interface {
+	StartQuiz(ctx context.Context, in *QuizConfig, opts ...grpc.CallOption) (QuizService_StartQuizClient, error)
+	SubmitAnswer(ctx context.Context, in *AnswerRequest, opts ...grpc.CallOption) (*AnswerResult, error)
+	GetScore(ctx context.Context, in *ScoreRequest, opts ...grpc.CallOption) (*ScoreResult, error)
+	ListQuizzes(ctx context.Context, in *ListQuizzesRequest, opts ...grpc.CallOption) (*ListQuizzesResult, error)
+}
+
+type quizServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+// NewQuizServiceClient returns a QuizServiceClient that invokes
+// QuizService's RPCs over cc.
+func NewQuizServiceClient(cc grpc.ClientConnInterface) QuizServiceClient {
+	return &quizServiceClient{cc}
+}
+
+func (c *quizServiceClient) StartQuiz(ctx context.Context, in *QuizConfig, opts ...grpc.CallOption) (QuizService_StartQuizClient, error) {
+	stream, err := c.cc.NewStream(ctx, &QuizService_ServiceDesc.Streams[0], QuizService_StartQuiz_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &quizServiceStartQuizClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// QuizService_StartQuizClient is the client-side stream handle for
+// StartQuiz.
+type QuizService_StartQuizClient interface {
+	Recv() (*Problem, error)
+	grpc.ClientStream
+}
+
+type quizServiceStartQuizClient struct {
+	grpc.ClientStream
+}
+
+func (x *quizServiceStartQuizClient) Recv() (*Problem, error) {
+	m := new(Problem)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *quizServiceClient) SubmitAnswer(ctx context.Context, in *AnswerRequest, opts ...grpc.CallOption) (*AnswerResult, error) {
+	out := new(AnswerResult)
+	if err := c.cc.Invoke(ctx, QuizService_SubmitAnswer_FullMethodName, in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) GetScore(ctx context.Context, in *ScoreRequest, opts ...grpc.CallOption) (*ScoreResult, error) {
+	out := new(ScoreResult)
+	if err := c.cc.Invoke(ctx, QuizService_GetScore_FullMethodName, in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quizServiceClient) ListQuizzes(ctx context.Context, in *ListQuizzesRequest, opts ...grpc.CallOption) (*ListQuizzesResult, error) {
+	out := new(ListQuizzesResult)
+	if err := c.cc.Invoke(ctx, QuizService_ListQuizzes_FullMethodName, in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QuizServiceServer is the server API for QuizService.
+type QuizServiceServer interface {
+	StartQuiz(*QuizConfig, QuizService_StartQuizServer) error
+	SubmitAnswer(context.Context, *AnswerRequest) (*AnswerResult, error)
+	GetScore(context.Context, *ScoreRequest) (*ScoreResult, error)
+	ListQuizzes(context.Context, *ListQuizzesRequest) (*ListQuizzesResult, error)
+}
+
+// QuizService_StartQuizServer is the server-side stream handle for
+// StartQuiz.
+type QuizService_StartQuizServer interface {
+	Send(*Problem) error
+	grpc.ServerStream
+}
+
+type quizServiceStartQuizServer struct {
+	grpc.ServerStream
+}
+
+func (x *quizServiceStartQuizServer) Send(m *Problem) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// UnimplementedQuizServiceServer must be embedded in QuizServiceServer
+// implementations for forward compatibility, as protoc-gen-go-grpc
+// generates for every service.
+type UnimplementedQuizServiceServer struct{}
+
+func (UnimplementedQuizServiceServer) StartQuiz(*QuizConfig, QuizService_StartQuizServer) error {
+	return grpcUnimplemented("StartQuiz")
+}
+
+func (UnimplementedQuizServiceServer) SubmitAnswer(context.Context, *AnswerRequest) (*AnswerResult, error) {
+	return nil, grpcUnimplemented("SubmitAnswer")
+}
+
+func (UnimplementedQuizServiceServer) GetScore(context.Context, *ScoreRequest) (*ScoreResult, error) {
+	return nil, grpcUnimplemented("GetScore")
+}
+
+func (UnimplementedQuizServiceServer) ListQuizzes(context.Context, *ListQuizzesRequest) (*ListQuizzesResult, error) {
+	return nil, grpcUnimplemented("ListQuizzes")
+}
+
+func _QuizService_StartQuiz_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QuizConfig)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QuizServiceServer).StartQuiz(m, &quizServiceStartQuizServer{stream})
+}
+
+func _QuizService_SubmitAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).SubmitAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: QuizService_SubmitAnswer_FullMethodName}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).SubmitAnswer(ctx, req.(*AnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_GetScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).GetScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: QuizService_GetScore_FullMethodName}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).GetScore(ctx, req.(*ScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuizService_ListQuizzes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuizzesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizServiceServer).ListQuizzes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: QuizService_ListQuizzes_FullMethodName}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizServiceServer).ListQuizzes(ctx, req.(*ListQuizzesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// QuizService_ServiceDesc is the grpc.ServiceDesc for QuizService,
+// passed to grpc.Server.RegisterService via RegisterQuizServiceServer.
+var QuizService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "quizpb.QuizService",
+	HandlerType: (*QuizServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "SubmitAnswer", Handler: _QuizService_SubmitAnswer_Handler},
+		{MethodName: "GetScore", Handler: _QuizService_GetScore_Handler},
+		{MethodName: "ListQuizzes", Handler: _QuizService_ListQuizzes_Handler},
+	},
+	Streams: []grpc.StreamDesc{
+		{StreamName: "StartQuiz", Handler: _QuizService_StartQuiz_Handler, ServerStreams: true},
+	},
+	Metadata: "quizserver/quiz.proto",
+}
+
+// RegisterQuizServiceServer registers srv with s so incoming RPCs for
+// QuizService are dispatched to it.
+func RegisterQuizServiceServer(s grpc.ServiceRegistrar, srv QuizServiceServer) {
+	s.RegisterService(&QuizService_ServiceDesc, srv)
+}
@@ -0,0 +1,86 @@
+package quizserver
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// validQuizName reports whether name is safe to interpolate into a CSV
+// path: no path separators and no "..", so a quiz name can never
+// resolve outside dir.
+func validQuizName(name string) bool {
+	return name != "" && !strings.ContainsAny(name, `/\`) && name != ".." && name != "."
+}
+
+// Problem is one question/answer pair loaded from a quiz CSV file, with
+// the same fields as quiz1.Problem.
+type Problem struct {
+	Question string
+	Answer   string
+}
+
+// QuizRepository is the backing store for QuizServer. It loads quizzes
+// by name from CSV files on disk, in the same "question, answer" format
+// quiz1's loadProblems reads.
+type QuizRepository struct {
+	// dir is the directory quiz CSV files are loaded from. A quiz named
+	// "quiz1" is expected to live at filepath.Join(dir, "quiz1.csv").
+	dir string
+}
+
+// NewQuizRepository returns a QuizRepository that loads quiz CSV files
+// from dir.
+func NewQuizRepository(dir string) *QuizRepository {
+	return &QuizRepository{dir: dir}
+}
+
+// Load reads and parses the CSV file for the named quiz.
+func (r *QuizRepository) Load(quizName string) ([]Problem, error) {
+	if !validQuizName(quizName) {
+		return nil, fmt.Errorf("invalid quiz name %q", quizName)
+	}
+	path := fmt.Sprintf("%s/%s.csv", r.dir, quizName)
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open quiz %q: %w", quizName, err)
+	}
+	defer file.Close()
+
+	lines, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse quiz %q: %w", quizName, err)
+	}
+	return parseLines(lines), nil
+}
+
+// List returns the names of the quizzes available in the repository.
+func (r *QuizRepository) List() ([]string, error) {
+	entries, err := os.ReadDir(r.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list quizzes: %w", err)
+	}
+
+	var names []string
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".csv") {
+			continue
+		}
+		names = append(names, strings.TrimSuffix(e.Name(), ".csv"))
+	}
+	return names, nil
+}
+
+// parseLines converts raw CSV rows into Problems, the same trimming
+// behavior quiz1.parseLines used.
+func parseLines(lines [][]string) []Problem {
+	ret := make([]Problem, len(lines))
+	for i, line := range lines {
+		ret[i] = Problem{
+			Question: strings.TrimSpace(line[0]),
+			Answer:   strings.TrimSpace(line[1]),
+		}
+	}
+	return ret
+}
@@ -0,0 +1,170 @@
+// Package quizserver exposes the quiz1 CSV quiz over gRPC, with a REST
+// façade generated by grpc-gateway so the same quiz can be driven from
+// a CLI, a browser, or a remote client. See quiz.proto for the service
+// definition and the Makefile's protoc-gen target for regenerating the
+// quizpb package.
+package quizserver
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"gophercises/quizserver/quizpb"
+)
+
+// session tracks one in-progress StartQuiz stream so SubmitAnswer and
+// GetScore can be called out-of-band against it.
+type session struct {
+	mu       sync.Mutex
+	problems []Problem
+	correct  int
+	answered int
+}
+
+// Server implements quizpb.QuizServiceServer against a QuizRepository.
+type Server struct {
+	quizpb.UnimplementedQuizServiceServer
+
+	repo           *QuizRepository
+	problemTimeout time.Duration
+
+	mu       sync.Mutex
+	sessions map[string]*session
+}
+
+// NewServer returns a Server backed by repo. problemTimeout bounds how
+// long StartQuiz waits for each problem to be answered before it ends
+// the stream, when the QuizConfig sets no time limit of its own; it is
+// the server-side counterpart of quiz1's per-problem time.NewTimer.
+func NewServer(repo *QuizRepository, problemTimeout time.Duration) *Server {
+	return &Server{
+		repo:           repo,
+		problemTimeout: problemTimeout,
+		sessions:       make(map[string]*session),
+	}
+}
+
+// StartQuiz streams the problems of cfg.QuizName one at a time. Each
+// problem is given up to cfg.TimeLimitSeconds (s.problemTimeout when that
+// is unset) to be answered via SubmitAnswer. The next problem is sent
+// once it is answered; if the time runs out first the stream ends,
+// mirroring the per-problem timer in quiz1's cli select loop.
+func (s *Server) StartQuiz(cfg *quizpb.QuizConfig, stream quizpb.QuizService_StartQuizServer) error {
+	problems, err := s.repo.Load(cfg.QuizName)
+	if err != nil {
+		return err
+	}
+
+	sessionID := fmt.Sprintf("%s-%d", cfg.QuizName, time.Now().UnixNano())
+	sess := &session{problems: problems}
+	s.mu.Lock()
+	s.sessions[sessionID] = sess
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.sessions, sessionID)
+		s.mu.Unlock()
+	}()
+
+	timeout := s.problemTimeout
+	if cfg.TimeLimitSeconds > 0 {
+		timeout = time.Duration(cfg.TimeLimitSeconds) * time.Second
+	}
+
+	for i, p := range problems {
+		if err := stream.Send(&quizpb.Problem{
+			SessionId: sessionID,
+			Index:     int32(i),
+			Question:  p.Question,
+		}); err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(stream.Context(), timeout)
+		if err := waitForAnswer(ctx, sess, i); err != nil {
+			cancel()
+			return nil
+		}
+		cancel()
+	}
+	return nil
+}
+
+// waitForAnswer blocks until problem index idx has been answered via
+// SubmitAnswer or ctx expires.
+func waitForAnswer(ctx context.Context, sess *session, idx int) error {
+	for {
+		sess.mu.Lock()
+		answered := sess.answered > idx
+		sess.mu.Unlock()
+		if answered {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+// SubmitAnswer grades answer against the current problem in the caller's
+// session.
+func (s *Server) SubmitAnswer(ctx context.Context, req *quizpb.AnswerRequest) (*quizpb.AnswerResult, error) {
+	s.mu.Lock()
+	sess, ok := s.sessions[req.SessionId]
+	s.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown session %q", req.SessionId)
+	}
+
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+
+	if int(req.Index) < 0 || int(req.Index) >= len(sess.problems) {
+		return nil, fmt.Errorf("answer index %d out of range for %d problems", req.Index, len(sess.problems))
+	}
+
+	correct := req.Answer == sess.problems[req.Index].Answer
+	if correct {
+		sess.correct++
+	}
+	if int(req.Index) >= sess.answered {
+		sess.answered = int(req.Index) + 1
+	}
+
+	return &quizpb.AnswerResult{
+		Correct:      correct,
+		CorrectCount: int32(sess.correct),
+		TotalCount:   int32(len(sess.problems)),
+	}, nil
+}
+
+// GetScore returns the running score for a quiz session.
+func (s *Server) GetScore(ctx context.Context, req *quizpb.ScoreRequest) (*quizpb.ScoreResult, error) {
+	s.mu.Lock()
+	sess, ok := s.sessions[req.SessionId]
+	s.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown session %q", req.SessionId)
+	}
+
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	return &quizpb.ScoreResult{
+		CorrectCount: int32(sess.correct),
+		TotalCount:   int32(len(sess.problems)),
+	}, nil
+}
+
+// ListQuizzes lists the quiz names known to the QuizRepository.
+func (s *Server) ListQuizzes(ctx context.Context, req *quizpb.ListQuizzesRequest) (*quizpb.ListQuizzesResult, error) {
+	names, err := s.repo.List()
+	if err != nil {
+		return nil, err
+	}
+	return &quizpb.ListQuizzesResult{QuizNames: names}, nil
+}
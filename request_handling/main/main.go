@@ -5,6 +5,10 @@ import (
 	"log"
 	"net/http"
 	"time"
+
+	"gophercises/middleware"
+	"gophercises/router"
+	"gophercises/tunnel"
 )
 
 type apiHandler struct {
@@ -58,11 +62,28 @@ func main() {
 
 	/*
 	Custom Handlers
+
+	/time/rfc1123, /time/rfc3339, /api/, and /api/v2 are registered on a
+	router.Router mounted into the mux below instead of being matched by
+	hand, which is what brings path params, method routing, and 405
+	Method-Not-Allowed to this demo. Requests are still wrapped in the
+	same middleware chain as before: request IDs, panic recovery,
+	timeouts, gzip/deflate negotiation, and structured logging.
 	 */
+	rtr := router.New()
+	rtr.Use(
+		middleware.RequestID,
+		middleware.RealIP,
+		middleware.Recoverer,
+		middleware.Timeout(5*time.Second),
+		middleware.Compress,
+		middleware.Logger,
+	)
+
 	th1123 := &timeHandler{format:time.RFC1123}
 	th3339 := &timeHandler{format:time.RFC3339}
-	mux.Handle("/time/rfc1123", th1123)
-	mux.Handle("/time/rfc3339", th3339)
+	rtr.Get("/time/rfc1123", th1123.ServeHTTP)
+	rtr.Get("/time/rfc3339", th3339.ServeHTTP)
 
 	/*
 	Functions as Handlers
@@ -84,15 +105,13 @@ func main() {
 	/*
 	Documentation example
 	 */
-	mux.Handle("/api/", apiHandler{})
-	mux.HandleFunc("/api/v2", func(writer http.ResponseWriter, request *http.Request) {
-		if request.URL.Path != "/api/v2" {
-			http.NotFound(writer, request)
-			return
-		}
+	rtr.Get("/api/", apiHandler{}.ServeHTTP)
+	rtr.Get("/api/v2", func(writer http.ResponseWriter, request *http.Request) {
 		fmt.Fprintf(writer, "Welcome to the home page!")
 	})
-	
+	mux.Handle("/time/", rtr)
+	mux.Handle("/api/", rtr)
+
 	/*
 	DefaultServerMux
 	var DefaultServeMux = NewServeMux()
@@ -112,5 +131,35 @@ func main() {
 	log.Println("Listening on port 3001 for DefaultServeMux...")
 	// run DefaultServeMux on port 3001
 	go http.ListenAndServe(":3001", nil)
+
+	/*
+	Tunnel demo
+
+	thTunnel below is never registered on mux or rtr directly -- it has no
+	listener of its own. tunnelServer exposes a websocket registration
+	endpoint and a public relay endpoint on the ServeMux we already have
+	listening on :3000, and the tunnel.Client dialing it forwards every
+	relayed request to thTunnel. That makes thTunnel reachable at
+	/tunnel/demo/... purely by going through the tunnel, the same way a
+	handler running behind NAT or a firewall could be exposed to the
+	public internet without opening a port for it.
+	 */
+	tunnelServer := tunnel.NewServer()
+	mux.HandleFunc("/tunnel/register", tunnelServer.RegisterHandler)
+	mux.Handle("/tunnel/", http.StripPrefix("/tunnel", http.HandlerFunc(tunnelServer.PublicHandler)))
+
+	time.Sleep(100 * time.Millisecond) // give the :3000 listener above a moment to come up
+	thTunnel := &timeHandler{format: time.RFC822}
+	tunnelClient := tunnel.NewClient(tunnel.Config{
+		Server:   "ws://localhost:3000/tunnel/register",
+		Token:    "demo",
+		Insecure: true,
+	}, thTunnel)
+	go func() {
+		if err := tunnelClient.Run(); err != nil {
+			log.Println("tunnel client stopped:", err)
+		}
+	}()
+
 	time.Sleep(10 * time.Minute)
 }
\ No newline at end of file
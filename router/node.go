@@ -0,0 +1,132 @@
+package router
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// node is one segment of the routing trie. Static segments live in
+// children keyed by their literal text; a single dynamic child per node
+// handles "{name}" and "{name:regexp}" segments.
+type node struct {
+	children map[string]*node
+
+	dynamic     *node
+	paramName   string
+	paramRegexp *regexp.Regexp
+
+	handlers map[string]http.Handler
+
+	// prefixHandlers holds routes registered with a trailing slash (e.g.
+	// "/api/"), which match this node's own path and anything beneath
+	// it that isn't otherwise matched more specifically — the same
+	// subtree semantics http.ServeMux gives a pattern ending in "/".
+	prefixHandlers map[string]http.Handler
+}
+
+// insert adds pattern (already containing the subrouter's prefix) to
+// the trie rooted at n, registering h for method.
+func (n *node) insert(pattern, method string, h http.Handler) {
+	isPrefix := strings.HasSuffix(pattern, "/") && pattern != "/"
+
+	cur := n
+	for _, seg := range splitPath(pattern) {
+		if name, re, ok := parseParamSegment(seg); ok {
+			if cur.dynamic == nil {
+				cur.dynamic = &node{children: map[string]*node{}}
+			}
+			cur.dynamic.paramName = name
+			cur.dynamic.paramRegexp = re
+			cur = cur.dynamic
+			continue
+		}
+
+		child, ok := cur.children[seg]
+		if !ok {
+			child = &node{children: map[string]*node{}}
+			cur.children[seg] = child
+		}
+		cur = child
+	}
+
+	if isPrefix {
+		if cur.prefixHandlers == nil {
+			cur.prefixHandlers = map[string]http.Handler{}
+		}
+		cur.prefixHandlers[method] = h
+		return
+	}
+
+	if cur.handlers == nil {
+		cur.handlers = map[string]http.Handler{}
+	}
+	cur.handlers[method] = h
+}
+
+// match walks segments against the trie, collecting path parameters
+// along the way, and returns the terminal node if the full path matched
+// some registered route (for any method).
+func (n *node) match(segments []string, params map[string]string) (*node, map[string]string, bool) {
+	if len(segments) == 0 {
+		if n.handlers != nil {
+			return n, params, true
+		}
+		if n.prefixHandlers != nil {
+			return &node{handlers: n.prefixHandlers}, params, true
+		}
+		return nil, nil, false
+	}
+
+	seg, rest := segments[0], segments[1:]
+
+	if child, ok := n.children[seg]; ok {
+		if match, p, ok := child.match(rest, params); ok {
+			return match, p, true
+		}
+	}
+
+	if n.dynamic != nil && (n.dynamic.paramRegexp == nil || n.dynamic.paramRegexp.MatchString(seg)) {
+		next := cloneParams(params)
+		next[n.dynamic.paramName] = seg
+		if match, p, ok := n.dynamic.match(rest, next); ok {
+			return match, p, true
+		}
+	}
+
+	if n.prefixHandlers != nil {
+		return &node{handlers: n.prefixHandlers}, params, true
+	}
+
+	return nil, nil, false
+}
+
+// parseParamSegment reports whether seg is a "{name}" or
+// "{name:regexp}" path parameter segment.
+func parseParamSegment(seg string) (name string, re *regexp.Regexp, ok bool) {
+	if !strings.HasPrefix(seg, "{") || !strings.HasSuffix(seg, "}") {
+		return "", nil, false
+	}
+	inner := seg[1 : len(seg)-1]
+	if i := strings.Index(inner, ":"); i >= 0 {
+		pattern := "^" + inner[i+1:] + "$"
+		return inner[:i], regexp.MustCompile(pattern), true
+	}
+	return inner, nil, true
+}
+
+func splitPath(path string) []string {
+	trimmed := strings.Trim(path, "/")
+	if trimmed == "" {
+		return nil
+	}
+	return strings.Split(trimmed, "/")
+}
+
+func cloneParams(params map[string]string) map[string]string {
+	next := make(map[string]string, len(params)+1)
+	for k, v := range params {
+		next[k] = v
+	}
+	return next
+}
@@ -0,0 +1,22 @@
+package router
+
+import (
+	"context"
+	"net/http"
+)
+
+type paramsKey struct{}
+
+// withParams stores the matched path parameters on ctx for URLParam to
+// retrieve.
+func withParams(ctx context.Context, params map[string]string) context.Context {
+	return context.WithValue(ctx, paramsKey{}, params)
+}
+
+// URLParam returns the value the router matched for the named path
+// parameter, e.g. URLParam(r, "id") for a route registered as
+// "/users/{id:[0-9]+}". It returns "" if name wasn't matched.
+func URLParam(r *http.Request, name string) string {
+	params, _ := r.Context().Value(paramsKey{}).(map[string]string)
+	return params[name]
+}
@@ -0,0 +1,118 @@
+// Package router is a trie-based HTTP router, in the spirit of
+// gorilla/mux and chi, that replaces the hand-rolled
+// `if request.URL.Path != "/api/v2"` guard in the ServeMux demo with
+// path parameters, method routing, subrouters, and proper
+// 405 Method-Not-Allowed handling.
+package router
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Middleware wraps an http.Handler with additional behavior, applied
+// around every route it's registered against. It's an alias for the
+// same func(http.Handler) http.Handler shape middleware.Middleware
+// uses, so chains built with either package compose directly.
+type Middleware = func(http.Handler) http.Handler
+
+// Router registers routes and serves them, matching gorilla/mux and
+// chi's vocabulary closely enough to be a drop-in replacement for
+// http.ServeMux in the handlers demo.
+type Router interface {
+	http.Handler
+
+	Get(pattern string, h http.HandlerFunc)
+	Post(pattern string, h http.HandlerFunc)
+	Put(pattern string, h http.HandlerFunc)
+	Patch(pattern string, h http.HandlerFunc)
+	Delete(pattern string, h http.HandlerFunc)
+	Handle(method, pattern string, h http.Handler)
+
+	// Use appends middleware applied to every route registered on this
+	// Router (and, since subrouters share the trie, its subrouters) from
+	// this point on.
+	Use(mw ...Middleware)
+
+	// Route mounts a subrouter under prefix. Routes registered inside fn
+	// are relative to prefix, e.g. Route("/api", func(r Router){
+	// r.Get("/users/{id}", h) }) registers "/api/users/{id}".
+	Route(prefix string, fn func(Router))
+}
+
+// New returns an empty Router.
+func New() Router {
+	return &muxRouter{root: &node{children: map[string]*node{}}}
+}
+
+type muxRouter struct {
+	root   *node
+	prefix string
+	mw     []Middleware
+}
+
+func (m *muxRouter) Get(pattern string, h http.HandlerFunc)    { m.Handle(http.MethodGet, pattern, h) }
+func (m *muxRouter) Post(pattern string, h http.HandlerFunc)   { m.Handle(http.MethodPost, pattern, h) }
+func (m *muxRouter) Put(pattern string, h http.HandlerFunc)    { m.Handle(http.MethodPut, pattern, h) }
+func (m *muxRouter) Patch(pattern string, h http.HandlerFunc)  { m.Handle(http.MethodPatch, pattern, h) }
+func (m *muxRouter) Delete(pattern string, h http.HandlerFunc) { m.Handle(http.MethodDelete, pattern, h) }
+
+func (m *muxRouter) Handle(method, pattern string, h http.Handler) {
+	full := joinPath(m.prefix, pattern)
+	wrapped := h
+	for i := len(m.mw) - 1; i >= 0; i-- {
+		wrapped = m.mw[i](wrapped)
+	}
+	m.root.insert(full, method, wrapped)
+}
+
+func (m *muxRouter) Use(mw ...Middleware) {
+	m.mw = append(m.mw, mw...)
+}
+
+func (m *muxRouter) Route(prefix string, fn func(Router)) {
+	sub := &muxRouter{root: m.root, prefix: joinPath(m.prefix, prefix), mw: append([]Middleware{}, m.mw...)}
+	fn(sub)
+}
+
+func (m *muxRouter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	segments := splitPath(r.URL.Path)
+	n, params, ok := m.root.match(segments, map[string]string{})
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	h, ok := n.handlers[r.Method]
+	if !ok {
+		if len(n.handlers) == 0 {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Allow", allowedMethods(n.handlers))
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	if len(params) > 0 {
+		r = r.WithContext(withParams(r.Context(), params))
+	}
+	h.ServeHTTP(w, r)
+}
+
+func allowedMethods(handlers map[string]http.Handler) string {
+	methods := make([]string, 0, len(handlers))
+	for method := range handlers {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+	return strings.Join(methods, ", ")
+}
+
+func joinPath(prefix, pattern string) string {
+	if prefix == "" {
+		return pattern
+	}
+	return strings.TrimRight(prefix, "/") + "/" + strings.TrimLeft(pattern, "/")
+}
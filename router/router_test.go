@@ -0,0 +1,70 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMethodNotAllowed(t *testing.T) {
+	r := New()
+	r.Get("/users", func(w http.ResponseWriter, req *http.Request) {})
+
+	req := httptest.NewRequest(http.MethodPost, "/users", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("expected status %d, got %d", http.StatusMethodNotAllowed, rec.Code)
+	}
+	if allow := rec.Header().Get("Allow"); allow != "GET" {
+		t.Fatalf("expected Allow header %q, got %q", "GET", allow)
+	}
+}
+
+func TestURLParamExtraction(t *testing.T) {
+	r := New()
+	var gotID string
+	r.Get("/users/{id:[0-9]+}", func(w http.ResponseWriter, req *http.Request) {
+		gotID = URLParam(req, "id")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/users/42", nil)
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	if gotID != "42" {
+		t.Fatalf("expected param id=42, got %q", gotID)
+	}
+}
+
+func TestURLParamRegexpRejectsNonMatchingSegment(t *testing.T) {
+	r := New()
+	r.Get("/users/{id:[0-9]+}", func(w http.ResponseWriter, req *http.Request) {})
+
+	req := httptest.NewRequest(http.MethodGet, "/users/not-a-number", nil)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d for non-matching param, got %d", http.StatusNotFound, rec.Code)
+	}
+}
+
+func TestNestedSubrouters(t *testing.T) {
+	r := New()
+	var hit string
+	r.Route("/api", func(api Router) {
+		api.Route("/v1", func(v1 Router) {
+			v1.Get("/ping", func(w http.ResponseWriter, req *http.Request) {
+				hit = "pong"
+			})
+		})
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/ping", nil)
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	if hit != "pong" {
+		t.Fatalf("expected nested subrouter route to be hit, got %q", hit)
+	}
+}
@@ -0,0 +1,48 @@
+package sitemap
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// fetchRobotsDisallow fetches /robots.txt for base's host and returns
+// the Disallow path prefixes listed under the "*" user-agent group.
+// Only a minimal subset of the robots.txt grammar is supported: enough
+// to avoid crawling paths a site has clearly opted out of.
+func fetchRobotsDisallow(ctx context.Context, client *http.Client, base *url.URL) ([]string, error) {
+	robotsURL := &url.URL{Scheme: base.Scheme, Host: base.Host, Path: "/robots.txt"}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, robotsURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil
+	}
+
+	var disallow []string
+	inWildcardGroup := false
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.HasPrefix(strings.ToLower(line), "user-agent:"):
+			agent := strings.TrimSpace(line[len("user-agent:"):])
+			inWildcardGroup = agent == "*"
+		case inWildcardGroup && strings.HasPrefix(strings.ToLower(line), "disallow:"):
+			path := strings.TrimSpace(line[len("disallow:"):])
+			if path != "" {
+				disallow = append(disallow, path)
+			}
+		}
+	}
+	return disallow, nil
+}
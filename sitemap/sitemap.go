@@ -0,0 +1,274 @@
+// Package sitemap BFS-crawls a site using link.Parse to discover pages
+// and emits a sitemaps.org-compliant urlset, built on top of the link
+// package from the link exercise.
+package sitemap
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"golang.org/x/net/html"
+	"golang.org/x/time/rate"
+
+	"gophercises/link"
+)
+
+// Options configures a Build crawl.
+type Options struct {
+	// MaxDepth bounds how many hops from startURL the crawl follows.
+	// Zero means only startURL itself is fetched.
+	MaxDepth int
+	// Workers is how many pages are fetched concurrently.
+	Workers int
+	// RespectRobotsTxt, when true, skips URLs disallowed by the site's
+	// robots.txt for user-agent "*".
+	RespectRobotsTxt bool
+	// RateLimit caps requests per second against the target host. Zero
+	// means unlimited.
+	RateLimit float64
+
+	// Client is used for all HTTP requests. http.DefaultClient is used
+	// if nil.
+	Client *http.Client
+}
+
+// URLSet is the root element of a sitemaps.org sitemap.
+type URLSet struct {
+	XMLName xml.Name  `xml:"urlset"`
+	Xmlns   string    `xml:"xmlns,attr"`
+	URLs    []URLEntry `xml:"url"`
+}
+
+// URLEntry is one <url> element of a sitemap.
+type URLEntry struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+const sitemapXmlns = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+// Build crawls startURL and same-host pages reachable from it, up to
+// opts.MaxDepth hops, and returns the resulting URLSet.
+func Build(ctx context.Context, startURL string, opts Options) (*URLSet, error) {
+	base, err := url.Parse(startURL)
+	if err != nil {
+		return nil, fmt.Errorf("sitemap: invalid start URL: %w", err)
+	}
+
+	client := opts.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	workers := opts.Workers
+	if workers < 1 {
+		workers = 1
+	}
+
+	var limiter *rate.Limiter
+	if opts.RateLimit > 0 {
+		limiter = rate.NewLimiter(rate.Limit(opts.RateLimit), 1)
+	}
+
+	var disallowed []string
+	if opts.RespectRobotsTxt {
+		disallowed, _ = fetchRobotsDisallow(ctx, client, base)
+	}
+
+	c := &crawler{
+		base:       base,
+		client:     client,
+		limiter:    limiter,
+		maxDepth:   opts.MaxDepth,
+		disallowed: disallowed,
+		visited:    make(map[string]bool),
+	}
+	c.visited[normalize(base, startURL)] = true
+
+	jobs := make(chan job, workers*4)
+	results := make(chan URLEntry)
+	var wg sync.WaitGroup
+
+	// c.jobs must be set before any worker can run or the seed job is
+	// enqueued: visitOne sends follow-up jobs on c.jobs from worker
+	// goroutines, and a worker could drain the seed job and try to
+	// queue a link from it before a later assignment ever happened,
+	// sending on a nil channel and blocking forever.
+	c.jobs = jobs
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go c.worker(ctx, jobs, results, &wg)
+	}
+
+	c.pending.Add(1)
+	jobs <- job{rawURL: base.String(), depth: 0}
+
+	go func() {
+		c.pending.Wait()
+		close(jobs)
+	}()
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	set := &URLSet{Xmlns: sitemapXmlns}
+	for entry := range results {
+		set.URLs = append(set.URLs, entry)
+	}
+	return set, nil
+}
+
+// job is one page queued for the crawler to fetch.
+type job struct {
+	rawURL string
+	depth  int
+}
+
+// crawler holds the mutable state of a single Build call.
+type crawler struct {
+	base       *url.URL
+	client     *http.Client
+	limiter    *rate.Limiter
+	maxDepth   int
+	disallowed []string
+
+	mu      sync.Mutex
+	visited map[string]bool
+
+	pending sync.WaitGroup
+	jobs    chan<- job
+}
+
+func (c *crawler) worker(ctx context.Context, jobs <-chan job, results chan<- URLEntry, wg *sync.WaitGroup) {
+	defer wg.Done()
+	for j := range jobs {
+		c.visitOne(ctx, j, results)
+		c.pending.Done()
+	}
+}
+
+func (c *crawler) visitOne(ctx context.Context, j job, results chan<- URLEntry) {
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, j.rawURL, nil)
+	if err != nil {
+		return
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	results <- URLEntry{Loc: j.rawURL, LastMod: parseLastModified(resp.Header.Get("Last-Modified"))}
+
+	if j.depth >= c.maxDepth {
+		return
+	}
+
+	doc, err := html.Parse(resp.Body)
+	if err != nil {
+		return
+	}
+
+	// A <base href> on the page, if present, overrides the page's own
+	// URL as the base relative hrefs resolve against.
+	resolveBase := j.rawURL
+	if baseHref := link.Base(doc); baseHref != "" {
+		if abs, ok := c.resolve(j.rawURL, baseHref); ok {
+			resolveBase = abs
+		}
+	}
+
+	for _, l := range link.Links(doc) {
+		next, ok := c.resolve(resolveBase, l.Href)
+		if !ok || isDisallowed(next, c.disallowed) {
+			continue
+		}
+
+		c.mu.Lock()
+		already := c.visited[next]
+		if !already {
+			c.visited[next] = true
+		}
+		c.mu.Unlock()
+		if already {
+			continue
+		}
+
+		c.pending.Add(1)
+		select {
+		case c.jobs <- job{rawURL: next, depth: j.depth + 1}:
+		case <-ctx.Done():
+			c.pending.Done()
+			return
+		}
+	}
+}
+
+// resolve turns href into an absolute, same-host URL with its fragment
+// stripped, the way a browser resolving a relative reference against
+// base would. Callers pass the page's own URL as base, or the page's
+// <base href> (resolved against the page URL) when it has one -- see
+// visitOne.
+func (c *crawler) resolve(base, href string) (string, bool) {
+	baseURL, err := url.Parse(base)
+	if err != nil {
+		return "", false
+	}
+	ref, err := url.Parse(href)
+	if err != nil {
+		return "", false
+	}
+	abs := baseURL.ResolveReference(ref)
+	abs.Fragment = ""
+
+	if abs.Host != c.base.Host {
+		return "", false
+	}
+	return abs.String(), true
+}
+
+func normalize(base *url.URL, rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	abs := base.ResolveReference(u)
+	abs.Fragment = ""
+	return abs.String()
+}
+
+func isDisallowed(rawURL string, disallowed []string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	for _, prefix := range disallowed {
+		if len(prefix) > 0 && len(u.Path) >= len(prefix) && u.Path[:len(prefix)] == prefix {
+			return true
+		}
+	}
+	return false
+}
+
+func parseLastModified(header string) string {
+	if header == "" {
+		return ""
+	}
+	t, err := http.ParseTime(header)
+	if err != nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,108 @@
+package tunnel
+
+import (
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+// Config holds the settings a Client needs to dial a tunnel Server.
+type Config struct {
+	// Server is the wss:// (or ws:// when Insecure) URL of the tunnel
+	// Server to dial, e.g. "wss://relay.example.com/register".
+	Server string
+	// Token authenticates this client with the Server. The Server
+	// assigns the subdomain/path that maps back to this token.
+	Token string
+	// TLSConfig is used for the websocket dial when set.
+	TLSConfig *tls.Config
+	// Insecure dials ws:// instead of wss:// and skips TLS entirely.
+	// Only intended for local testing against a Server run without TLS.
+	Insecure bool
+}
+
+// Client dials a tunnel Server and forwards the HTTP requests it
+// receives over the websocket to a local http.Handler, so that handler
+// becomes reachable from wherever the Server is exposed publicly.
+type Client struct {
+	cfg     Config
+	handler http.Handler
+
+	// writeMu serializes WriteMessage calls on conn: each relayed
+	// request is served on its own goroutine in Run, but
+	// gorilla/websocket only supports one concurrent writer per
+	// connection.
+	writeMu sync.Mutex
+}
+
+// NewClient returns a Client that forwards relayed requests to handler.
+func NewClient(cfg Config, handler http.Handler) *Client {
+	return &Client{cfg: cfg, handler: handler}
+}
+
+// Run dials the Server and services relayed requests until the
+// connection is closed or an unrecoverable error occurs.
+func (c *Client) Run() error {
+	server := c.cfg.Server
+	dialer := websocket.Dialer{TLSClientConfig: c.cfg.TLSConfig}
+	if c.cfg.Insecure {
+		if strings.HasPrefix(server, "wss://") {
+			server = "ws://" + strings.TrimPrefix(server, "wss://")
+		}
+		dialer.TLSClientConfig = nil
+	}
+	header := http.Header{}
+	header.Set("Authorization", "Bearer "+c.cfg.Token)
+
+	conn, _, err := dialer.Dial(server, header)
+	if err != nil {
+		return fmt.Errorf("tunnel: failed to dial %s: %w", c.cfg.Server, err)
+	}
+	defer conn.Close()
+
+	for {
+		_, raw, err := conn.ReadMessage()
+		if err != nil {
+			if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+				return nil
+			}
+			return fmt.Errorf("tunnel: connection to %s lost: %w", c.cfg.Server, err)
+		}
+
+		req, err := unmarshalRequest(raw)
+		if err != nil {
+			continue
+		}
+		go c.serve(conn, req)
+	}
+}
+
+// serve replays req against the local handler and frames the result
+// back to the Server as a responseMessage.
+func (c *Client) serve(conn *websocket.Conn, req requestMessage) {
+	httpReq := httptest.NewRequest(req.Method, req.Path, bytes.NewReader(req.Body))
+	httpReq.Header = req.Header
+
+	rec := newResponseRecorder()
+	c.handler.ServeHTTP(rec, httpReq)
+
+	resp, err := marshalResponse(responseMessage{
+		ID:     req.ID,
+		Status: rec.status,
+		Header: rec.header,
+		Body:   rec.body.Bytes(),
+	})
+	if err != nil {
+		return
+	}
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	_ = conn.WriteMessage(websocket.TextMessage, resp)
+}
@@ -0,0 +1,65 @@
+package tunnel
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+)
+
+// Each requestMessage/responseMessage is sent as its own websocket
+// message, relying on the websocket protocol's own length-prefixed
+// framing rather than layering a second one on top.
+
+// requestMessage is the JSON payload framed over the websocket for each
+// inbound public HTTP request the Server relays to a Client.
+type requestMessage struct {
+	ID     string      `json:"id"`
+	Method string      `json:"method"`
+	Path   string      `json:"path"`
+	Header http.Header `json:"header"`
+	Body   []byte      `json:"body"`
+}
+
+// responseMessage is the JSON payload the Client frames back once the
+// local http.Handler has produced a response.
+type responseMessage struct {
+	ID     string      `json:"id"`
+	Status int         `json:"status"`
+	Header http.Header `json:"header"`
+	Body   []byte      `json:"body"`
+}
+
+// responseRecorder captures the output of an http.Handler invocation so
+// it can be serialized into a responseMessage, the same role
+// httptest.ResponseRecorder plays in tests.
+type responseRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func newResponseRecorder() *responseRecorder {
+	return &responseRecorder{header: make(http.Header), status: http.StatusOK}
+}
+
+func (r *responseRecorder) Header() http.Header { return r.header }
+
+func (r *responseRecorder) Write(b []byte) (int, error) { return r.body.Write(b) }
+
+func (r *responseRecorder) WriteHeader(status int) { r.status = status }
+
+func marshalRequest(m requestMessage) ([]byte, error) { return json.Marshal(m) }
+
+func unmarshalRequest(b []byte) (requestMessage, error) {
+	var m requestMessage
+	err := json.Unmarshal(b, &m)
+	return m, err
+}
+
+func marshalResponse(m responseMessage) ([]byte, error) { return json.Marshal(m) }
+
+func unmarshalResponse(b []byte) (responseMessage, error) {
+	var m responseMessage
+	err := json.Unmarshal(b, &m)
+	return m, err
+}
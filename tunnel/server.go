@@ -0,0 +1,206 @@
+package tunnel
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// pendingRequest tracks a relayed request waiting for its
+// responseMessage to come back from the Client.
+type pendingRequest struct {
+	done chan responseMessage
+}
+
+// clientConn is a single registered Client's websocket connection.
+type clientConn struct {
+	conn *websocket.Conn
+
+	// writeMu serializes WriteMessage calls on conn: PublicHandler
+	// relays every public request on its own goroutine, but
+	// gorilla/websocket only supports one concurrent writer per
+	// connection.
+	writeMu sync.Mutex
+
+	mu      sync.Mutex
+	pending map[string]*pendingRequest
+}
+
+func (cc *clientConn) writeMessage(messageType int, data []byte) error {
+	cc.writeMu.Lock()
+	defer cc.writeMu.Unlock()
+	return cc.conn.WriteMessage(messageType, data)
+}
+
+// Server accepts websocket connections from tunnel Clients, assigns
+// each a path token, and multiplexes incoming public HTTP requests to
+// the matching client over its websocket connection.
+type Server struct {
+	// Authenticate validates the bearer token a Client presents when
+	// registering and returns the path token to assign it, e.g. deriving
+	// a subdomain/path from an account ID. A nil Authenticate accepts
+	// any token and assigns it as its own path token.
+	Authenticate func(token string) (pathToken string, ok bool)
+
+	// RequestTimeout bounds how long a public request waits for its
+	// Client to answer before the Server gives up with 504.
+	RequestTimeout time.Duration
+
+	upgrader websocket.Upgrader
+
+	mu      sync.Mutex
+	clients map[string]*clientConn
+}
+
+// NewServer returns a Server ready to accept Client registrations and
+// relay public requests.
+func NewServer() *Server {
+	return &Server{
+		RequestTimeout: 30 * time.Second,
+		clients:        make(map[string]*clientConn),
+	}
+}
+
+// RegisterHandler upgrades an incoming Client connection to a websocket
+// and keeps relaying requests assigned to it until it disconnects. Mount
+// this at the path Clients dial, e.g. "/register".
+func (s *Server) RegisterHandler(w http.ResponseWriter, r *http.Request) {
+	token := bearerToken(r)
+	pathToken, ok := s.authenticate(token)
+	if !ok {
+		http.Error(w, "invalid token", http.StatusUnauthorized)
+		return
+	}
+
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+
+	cc := &clientConn{conn: conn, pending: make(map[string]*pendingRequest)}
+	s.mu.Lock()
+	s.clients[pathToken] = cc
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.clients, pathToken)
+		s.mu.Unlock()
+		conn.Close()
+	}()
+
+	for {
+		_, raw, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		resp, err := unmarshalResponse(raw)
+		if err != nil {
+			continue
+		}
+		cc.mu.Lock()
+		if p, ok := cc.pending[resp.ID]; ok {
+			delete(cc.pending, resp.ID)
+			p.done <- resp
+		}
+		cc.mu.Unlock()
+	}
+}
+
+// PublicHandler relays incoming public HTTP requests to the Client
+// registered under the path token in r.URL.Path's first segment, e.g.
+// "/<pathToken>/rest/of/path".
+func (s *Server) PublicHandler(w http.ResponseWriter, r *http.Request) {
+	pathToken, rest := splitPathToken(r.URL.Path)
+
+	s.mu.Lock()
+	cc, ok := s.clients[pathToken]
+	s.mu.Unlock()
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	body, _ := io.ReadAll(r.Body)
+	reqMsg := requestMessage{
+		ID:     newRequestID(),
+		Method: r.Method,
+		Path:   rest,
+		Header: r.Header,
+		Body:   body,
+	}
+
+	raw, err := marshalRequest(reqMsg)
+	if err != nil {
+		http.Error(w, "failed to relay request", http.StatusInternalServerError)
+		return
+	}
+
+	pending := &pendingRequest{done: make(chan responseMessage, 1)}
+	cc.mu.Lock()
+	cc.pending[reqMsg.ID] = pending
+	cc.mu.Unlock()
+
+	if err := cc.writeMessage(websocket.TextMessage, raw); err != nil {
+		http.Error(w, "client disconnected", http.StatusBadGateway)
+		return
+	}
+
+	select {
+	case resp := <-pending.done:
+		for k, vs := range resp.Header {
+			for _, v := range vs {
+				w.Header().Add(k, v)
+			}
+		}
+		w.WriteHeader(resp.Status)
+		w.Write(resp.Body)
+	case <-time.After(s.RequestTimeout):
+		cc.mu.Lock()
+		delete(cc.pending, reqMsg.ID)
+		cc.mu.Unlock()
+		http.Error(w, "gateway timeout", http.StatusGatewayTimeout)
+	}
+}
+
+func (s *Server) authenticate(token string) (string, bool) {
+	if s.Authenticate == nil {
+		return token, token != ""
+	}
+	return s.Authenticate(token)
+}
+
+func bearerToken(r *http.Request) string {
+	const prefix = "Bearer "
+	auth := r.Header.Get("Authorization")
+	if len(auth) > len(prefix) && auth[:len(prefix)] == prefix {
+		return auth[len(prefix):]
+	}
+	return ""
+}
+
+// splitPathToken pulls the leading path segment (the client's path
+// token) off of path, returning the remainder to forward.
+func splitPathToken(path string) (token string, rest string) {
+	trimmed := path
+	if len(trimmed) > 0 && trimmed[0] == '/' {
+		trimmed = trimmed[1:]
+	}
+	for i := 0; i < len(trimmed); i++ {
+		if trimmed[i] == '/' {
+			return trimmed[:i], trimmed[i:]
+		}
+	}
+	return trimmed, "/"
+}
+
+func newRequestID() string {
+	var b [8]byte
+	_, _ = rand.Read(b[:])
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(b[:]), time.Now().UnixNano())
+}